@@ -0,0 +1,12 @@
+package adapter
+
+import (
+	"errors"
+)
+
+var (
+	ErrAdapterNotRegistered     = errors.New("adapter not registered")
+	ErrAdapterAlreadyRegistered = errors.New("adapter already registered")
+	ErrInvalidConfig            = errors.New("invalid adapter config")
+	ErrValueMustBeBytes         = errors.New("value must be a []byte")
+)
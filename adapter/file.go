@@ -0,0 +1,188 @@
+package adapter
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"os"
+	"sync"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+type (
+	// fileConfig describes the fields accepted in the JSON config string
+	// passed to the "file" adapter
+	fileConfig struct {
+		Path string `json:"path"`
+	}
+
+	// fileCache adapts a gob-encoded file on disk to gocache.Cache, holding
+	// the decoded contents in memory and flushing them back to disk on
+	// every mutation
+	fileCache struct {
+		path  string
+		items map[string]interface{}
+		mutex sync.Mutex
+	}
+)
+
+func init() {
+	_ = Register("file", newFileCache)
+}
+
+// newFileCache builds a Factory-compatible cache backed by a gob-encoded
+// file on disk, loading any existing contents at path
+//
+// Parameters:
+//
+//   - config: A JSON string of the form {"path":"..."}
+//
+// Returns:
+//
+//   - gocache.Cache: The newly created file-backed cache
+//   - error: An error if config cannot be parsed or the file cannot be read
+func newFileCache(config string) (gocache.Cache, error) {
+	var cfg fileConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return nil, ErrInvalidConfig
+	}
+
+	f := &fileCache{path: cfg.Path, items: make(map[string]interface{})}
+
+	file, err := os.Open(cfg.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return f, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	if err := gob.NewDecoder(file).Decode(&f.items); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// flush persists the in-memory items to disk, overwriting the file at path
+//
+// Returns:
+//
+//   - error: An error if the file cannot be written
+func (f *fileCache) flush() error {
+	file, err := os.Create(f.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return gob.NewEncoder(file).Encode(f.items)
+}
+
+// Set adds the value to the cache and flushes the cache to disk
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or the file cannot be written
+func (f *fileCache) Set(key string, value interface{}) error {
+	if f == nil {
+		return gocache.ErrNilCache
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.items[key] = value
+	return f.flush()
+}
+
+// UpdateValue updates the value of an existing key and flushes the cache to disk
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be set
+//
+// Returns:
+//
+//   - error: An error if the key is not found or the file cannot be written
+func (f *fileCache) UpdateValue(key string, value interface{}) error {
+	if f == nil {
+		return gocache.ErrNilCache
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if _, found := f.items[key]; !found {
+		return gocache.ErrItemNotFound
+	}
+
+	f.items[key] = value
+	return f.flush()
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in the cache, false otherwise
+func (f *fileCache) Has(key string) bool {
+	if f == nil {
+		return false
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	_, found := f.items[key]
+	return found
+}
+
+// Get retrieves a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - interface{}: The cached value, or nil if not found
+//   - bool: True if the value was found, false otherwise
+func (f *fileCache) Get(key string) (interface{}, bool) {
+	if f == nil {
+		return nil, false
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	value, found := f.items[key]
+	return value, found
+}
+
+// Delete removes a value from the cache and flushes the cache to disk
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (f *fileCache) Delete(key string) {
+	if f == nil {
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.items, key)
+	_ = f.flush()
+}
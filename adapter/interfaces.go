@@ -0,0 +1,14 @@
+package adapter
+
+import (
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+type (
+	// Factory builds a gocache.Cache backend from a JSON config string.
+	//
+	// gocache.Cache.Set takes no TTL argument, so every adapter built from a
+	// Factory stores values with no expiration; callers who need per-item
+	// TTLs should reach for the timed package directly instead of an adapter.
+	Factory func(config string) (gocache.Cache, error)
+)
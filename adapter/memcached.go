@@ -0,0 +1,142 @@
+package adapter
+
+import (
+	"encoding/json"
+
+	"github.com/bradfitz/gomemcache/memcache"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+type (
+	// memcachedConfig describes the fields accepted in the JSON config
+	// string passed to the "memcached" adapter
+	memcachedConfig struct {
+		Addrs []string `json:"addrs"`
+	}
+
+	// memcachedCache adapts a *memcache.Client to gocache.Cache
+	memcachedCache struct {
+		client *memcache.Client
+	}
+)
+
+func init() {
+	_ = Register("memcached", newMemcachedCache)
+}
+
+// newMemcachedCache builds a Factory-compatible cache backed by Memcached
+//
+// Parameters:
+//
+//   - config: A JSON string of the form {"addrs":["host:port", ...]}
+//
+// Returns:
+//
+//   - gocache.Cache: The newly created Memcached-backed cache
+//   - error: An error if config cannot be parsed
+func newMemcachedCache(config string) (gocache.Cache, error) {
+	var cfg memcachedConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return nil, ErrInvalidConfig
+	}
+
+	return &memcachedCache{client: memcache.New(cfg.Addrs...)}, nil
+}
+
+// Set adds the value to the cache with no expiration
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached, which must be a []byte
+//
+// Returns:
+//
+//   - error: An error if the cache is nil, the value is not a []byte, or the command fails
+func (m *memcachedCache) Set(key string, value interface{}) error {
+	if m == nil {
+		return gocache.ErrNilCache
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return ErrValueMustBeBytes
+	}
+
+	return m.client.Set(&memcache.Item{Key: key, Value: bytes})
+}
+
+// UpdateValue updates the value of an existing key
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be set, which must be a []byte
+//
+// Returns:
+//
+//   - error: An error if the key is not found or the command fails
+func (m *memcachedCache) UpdateValue(key string, value interface{}) error {
+	if m == nil {
+		return gocache.ErrNilCache
+	}
+
+	if !m.Has(key) {
+		return gocache.ErrItemNotFound
+	}
+
+	return m.Set(key, value)
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in Memcached, false otherwise
+func (m *memcachedCache) Has(key string) bool {
+	if m == nil {
+		return false
+	}
+
+	_, found := m.Get(key)
+	return found
+}
+
+// Get retrieves a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - interface{}: The cached []byte value, or nil if not found
+//   - bool: True if the value was found, false otherwise
+func (m *memcachedCache) Get(key string) (interface{}, bool) {
+	if m == nil {
+		return nil, false
+	}
+
+	item, err := m.client.Get(key)
+	if err != nil {
+		return nil, false
+	}
+	return item.Value, true
+}
+
+// Delete removes a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (m *memcachedCache) Delete(key string) {
+	if m == nil {
+		return
+	}
+	_ = m.client.Delete(key)
+}
@@ -0,0 +1,119 @@
+package adapter
+
+import (
+	"time"
+
+	gocache "github.com/ralvarezdev/go-cache"
+	"github.com/ralvarezdev/go-cache/timed"
+)
+
+// noExpiration is the TTL applied to values set through memoryCache, since
+// the gocache.Cache interface has no TTL parameter of its own and the other
+// adapters (redis, memcached, file) treat a plain Set as never expiring
+const noExpiration = 100 * 365 * 24 * time.Hour
+
+// memoryCache adapts a *timed.DefaultTimedCache to gocache.Cache by
+// wrapping plain values in a *timed.TimedItem on Set, since
+// timed.DefaultTimedCache.Set otherwise requires one directly
+type memoryCache struct {
+	cache *timed.DefaultTimedCache
+}
+
+func init() {
+	_ = Register("memory", newMemoryCache)
+}
+
+// newMemoryCache builds a Factory-compatible in-process cache backed by
+// timed.DefaultTimedCache. It ignores config, as the in-process backend
+// takes no connection parameters
+//
+// Parameters:
+//
+//   - config: Unused
+//
+// Returns:
+//
+//   - gocache.Cache: The newly created in-process cache
+//   - error: Always nil
+func newMemoryCache(config string) (gocache.Cache, error) {
+	return &memoryCache{cache: timed.NewDefaultTimedCache()}, nil
+}
+
+// Set adds the value to the cache
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//
+// Returns:
+//
+//   - error: An error if the cache is nil
+func (m *memoryCache) Set(key string, value interface{}) error {
+	if m == nil {
+		return gocache.ErrNilCache
+	}
+	return m.cache.Set(key, timed.NewTimedItem(value, time.Now().Add(noExpiration)))
+}
+
+// UpdateValue updates the value of an item in the cache
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be set
+//
+// Returns:
+//
+//   - error: An error if the item is not found
+func (m *memoryCache) UpdateValue(key string, value interface{}) error {
+	if m == nil {
+		return gocache.ErrNilCache
+	}
+	return m.cache.UpdateValue(key, value)
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in the cache, false otherwise
+func (m *memoryCache) Has(key string) bool {
+	if m == nil {
+		return false
+	}
+	return m.cache.Has(key)
+}
+
+// Get retrieves a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - interface{}: The cached value, or nil if not found
+//   - bool: True if the value was found, false otherwise
+func (m *memoryCache) Get(key string) (interface{}, bool) {
+	if m == nil {
+		return nil, false
+	}
+	return m.cache.Get(key)
+}
+
+// Delete removes a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (m *memoryCache) Delete(key string) {
+	if m == nil {
+		return
+	}
+	m.cache.Delete(key)
+}
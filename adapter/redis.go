@@ -0,0 +1,156 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+type (
+	// redisConfig describes the fields accepted in the JSON config string
+	// passed to the "redis" adapter
+	redisConfig struct {
+		Addr     string `json:"addr"`
+		Password string `json:"password"`
+		DB       int    `json:"db"`
+	}
+
+	// redisCache adapts a *redis.Client to gocache.Cache
+	redisCache struct {
+		client *redis.Client
+		ctx    context.Context
+	}
+)
+
+func init() {
+	_ = Register("redis", newRedisCache)
+}
+
+// newRedisCache builds a Factory-compatible cache backed by Redis
+//
+// Parameters:
+//
+//   - config: A JSON string of the form {"addr":"...","password":"...","db":0}
+//
+// Returns:
+//
+//   - gocache.Cache: The newly created Redis-backed cache
+//   - error: An error if config cannot be parsed
+func newRedisCache(config string) (gocache.Cache, error) {
+	var cfg redisConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return nil, ErrInvalidConfig
+	}
+
+	client := redis.NewClient(
+		&redis.Options{
+			Addr:     cfg.Addr,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		},
+	)
+
+	return &redisCache{client: client, ctx: context.Background()}, nil
+}
+
+// Set adds the value to the cache with no expiration
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or the Redis command fails
+func (r *redisCache) Set(key string, value interface{}) error {
+	if r == nil {
+		return gocache.ErrNilCache
+	}
+	return r.client.Set(r.ctx, key, value, 0).Err()
+}
+
+// UpdateValue updates the value of an existing key, preserving its TTL
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be set
+//
+// Returns:
+//
+//   - error: An error if the key is not found or the Redis command fails
+func (r *redisCache) UpdateValue(key string, value interface{}) error {
+	if r == nil {
+		return gocache.ErrNilCache
+	}
+
+	ttl, err := r.client.TTL(r.ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	if ttl < 0 {
+		ttl = 0
+	}
+
+	if !r.Has(key) {
+		return gocache.ErrItemNotFound
+	}
+
+	return r.client.Set(r.ctx, key, value, ttl).Err()
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in Redis, false otherwise
+func (r *redisCache) Has(key string) bool {
+	if r == nil {
+		return false
+	}
+
+	n, err := r.client.Exists(r.ctx, key).Result()
+	return err == nil && n > 0
+}
+
+// Get retrieves a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - interface{}: The cached value, or nil if not found
+//   - bool: True if the value was found, false otherwise
+func (r *redisCache) Get(key string) (interface{}, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	value, err := r.client.Get(r.ctx, key).Result()
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Delete removes a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (r *redisCache) Delete(key string) {
+	if r == nil {
+		return
+	}
+	r.client.Del(r.ctx, key)
+}
@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"sync"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+var (
+	registryMutex sync.RWMutex
+	registry      = make(map[string]Factory)
+)
+
+// Register associates a name with a Factory so that NewCache can later
+// build an instance of that backend by name
+//
+// Parameters:
+//
+//   - name: The name callers will use to request this backend
+//   - factory: The function that builds the backend from a config string
+//
+// Returns:
+//
+//   - error: An error if the name is already registered
+func Register(name string, factory Factory) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, found := registry[name]; found {
+		return ErrAdapterAlreadyRegistered
+	}
+
+	registry[name] = factory
+	return nil
+}
+
+// NewCache builds a gocache.Cache backend previously registered under name
+//
+// Parameters:
+//
+//   - name: The name the backend was registered under
+//   - config: The JSON config string passed to the backend's Factory
+//
+// Returns:
+//
+//   - gocache.Cache: The constructed cache backend
+//   - error: An error if the name is not registered or the backend fails to build
+func NewCache(name string, config string) (gocache.Cache, error) {
+	registryMutex.RLock()
+	factory, found := registry[name]
+	registryMutex.RUnlock()
+
+	if !found {
+		return nil, ErrAdapterNotRegistered
+	}
+
+	return factory(config)
+}
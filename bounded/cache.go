@@ -0,0 +1,327 @@
+package bounded
+
+import (
+	"container/heap"
+	"container/list"
+	"sync"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+type (
+	// EvictionPolicy selects which entry BoundedCache evicts once it is full
+	EvictionPolicy int
+
+	// entry is the value stored in each list.Element. When the cache's
+	// policy is EvictLFU, it is also stored in the lfuHeap, with element
+	// pointing back to its list.Element so a heap-driven eviction can
+	// remove it from the list and map in O(1)
+	entry struct {
+		key       string
+		value     interface{}
+		frequency int
+		seq       int64
+		index     int
+		element   *list.Element
+	}
+
+	// BoundedCache is a fixed-capacity cache that evicts entries according
+	// to the configured EvictionPolicy once it grows past its maximum size
+	BoundedCache struct {
+		maxEntries int
+		policy     EvictionPolicy
+		items      map[string]*list.Element
+		order      *list.List
+		lfuHeap    lfuHeap
+		nextSeq    int64
+		onEvict    func(key string, value interface{})
+		mutex      sync.RWMutex
+	}
+)
+
+const (
+	// EvictLRU evicts the least recently used entry
+	EvictLRU EvictionPolicy = iota
+	// EvictLFU evicts the least frequently used entry
+	EvictLFU
+	// EvictFIFO evicts the oldest inserted entry, regardless of usage
+	EvictFIFO
+)
+
+// NewBoundedCache creates a new BoundedCache instance
+//
+// Parameters:
+//
+//   - maxEntries: The maximum number of entries the cache may hold; must be greater than zero
+//   - policy: The eviction policy to apply once the cache is full
+//
+// Returns:
+//
+//   - *BoundedCache: A pointer to the newly created cache instance
+//   - error: ErrInvalidMaxEntries if maxEntries is not positive, or ErrInvalidEvictionPolicy if policy is unrecognized
+func NewBoundedCache(maxEntries int, policy EvictionPolicy) (*BoundedCache, error) {
+	if maxEntries <= 0 {
+		return nil, ErrInvalidMaxEntries
+	}
+
+	switch policy {
+	case EvictLRU, EvictLFU, EvictFIFO:
+	default:
+		return nil, ErrInvalidEvictionPolicy
+	}
+
+	return &BoundedCache{
+		maxEntries: maxEntries,
+		policy:     policy,
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+		lfuHeap:    make(lfuHeap, 0),
+	}, nil
+}
+
+// OnEvict registers a callback invoked with the key and value of every
+// entry the cache evicts to stay within its capacity
+//
+// Parameters:
+//
+//   - fn: The callback to invoke on eviction
+func (b *BoundedCache) OnEvict(fn func(key string, value interface{})) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.onEvict = fn
+}
+
+// Len returns the number of entries currently stored in the cache
+//
+// Returns:
+//
+//   - int: The number of entries in the cache
+func (b *BoundedCache) Len() int {
+	if b == nil {
+		return 0
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.order.Len()
+}
+
+// Cap returns the maximum number of entries the cache may hold
+//
+// Returns:
+//
+//   - int: The cache's maximum capacity
+func (b *BoundedCache) Cap() int {
+	if b == nil {
+		return 0
+	}
+	return b.maxEntries
+}
+
+// Set adds the value to the cache, evicting an entry chosen by the
+// configured EvictionPolicy if the cache is at capacity
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//
+// Returns:
+//
+//   - error: An error if the cache is nil
+func (b *BoundedCache) Set(key string, value interface{}) error {
+	if b == nil {
+		return gocache.ErrNilCache
+	}
+
+	b.mutex.Lock()
+
+	if el, found := b.items[key]; found {
+		el.Value.(*entry).value = value
+		b.touch(el)
+		b.mutex.Unlock()
+		return nil
+	}
+
+	e := &entry{key: key, value: value, frequency: 1, seq: b.nextSeq}
+	b.nextSeq++
+
+	el := b.order.PushFront(e)
+	e.element = el
+	b.items[key] = el
+
+	if b.policy == EvictLFU {
+		heap.Push(&b.lfuHeap, e)
+	}
+
+	var evictedKey string
+	var evictedValue interface{}
+	var evicted bool
+	if b.order.Len() > b.maxEntries {
+		evictedKey, evictedValue, evicted = b.evict()
+	}
+	onEvict := b.onEvict
+	b.mutex.Unlock()
+
+	if evicted && onEvict != nil {
+		onEvict(evictedKey, evictedValue)
+	}
+	return nil
+}
+
+// UpdateValue updates the value of an existing cache entry
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be set
+//
+// Returns:
+//
+//   - error: An error if the item is not found
+func (b *BoundedCache) UpdateValue(key string, value interface{}) error {
+	if b == nil {
+		return gocache.ErrNilCache
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	el, found := b.items[key]
+	if !found {
+		return gocache.ErrItemNotFound
+	}
+
+	el.Value.(*entry).value = value
+	b.touch(el)
+	return nil
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in the cache, false otherwise
+func (b *BoundedCache) Has(key string) bool {
+	if b == nil {
+		return false
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	_, found := b.items[key]
+	return found
+}
+
+// Get retrieves a value from the cache, promoting it according to the
+// configured EvictionPolicy
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - interface{}: The cached value, or nil if not found
+//   - bool: True if the value was found, false otherwise
+func (b *BoundedCache) Get(key string) (interface{}, bool) {
+	if b == nil {
+		return nil, false
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	el, found := b.items[key]
+	if !found {
+		return nil, false
+	}
+
+	b.touch(el)
+	return el.Value.(*entry).value, true
+}
+
+// Delete removes a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (b *BoundedCache) Delete(key string) {
+	if b == nil {
+		return
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if el, found := b.items[key]; found {
+		if b.policy == EvictLFU {
+			heap.Remove(&b.lfuHeap, el.Value.(*entry).index)
+		}
+		b.order.Remove(el)
+		delete(b.items, key)
+	}
+}
+
+// touch updates an element's position or frequency to reflect a fresh
+// access, according to the configured EvictionPolicy
+//
+// Parameters:
+//
+//   - el: The accessed element
+func (b *BoundedCache) touch(el *list.Element) {
+	switch b.policy {
+	case EvictLRU:
+		b.order.MoveToFront(el)
+	case EvictLFU:
+		e := el.Value.(*entry)
+		e.frequency++
+		heap.Fix(&b.lfuHeap, e.index)
+	case EvictFIFO:
+		// FIFO ignores access patterns; insertion order is preserved
+	}
+}
+
+// evict removes the entry chosen by the configured EvictionPolicy and
+// reports its key and value
+//
+// Returns:
+//
+//   - string: The evicted key
+//   - interface{}: The evicted value
+//   - bool: True if an entry was evicted, false if the cache was empty
+func (b *BoundedCache) evict() (string, interface{}, bool) {
+	if b.policy == EvictLFU {
+		if b.lfuHeap.Len() == 0 {
+			return "", nil, false
+		}
+
+		victimEntry := heap.Pop(&b.lfuHeap).(*entry)
+		b.order.Remove(victimEntry.element)
+		delete(b.items, victimEntry.key)
+		return victimEntry.key, victimEntry.value, true
+	}
+
+	// LRU and FIFO both evict from the back of the list: the least
+	// recently moved-to-front element, or the oldest inserted one
+	victim := b.order.Back()
+	if victim == nil {
+		return "", nil, false
+	}
+
+	victimEntry := victim.Value.(*entry)
+	b.order.Remove(victim)
+	delete(b.items, victimEntry.key)
+	return victimEntry.key, victimEntry.value, true
+}
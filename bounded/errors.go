@@ -0,0 +1,10 @@
+package bounded
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidMaxEntries     = errors.New("max entries must be greater than zero")
+	ErrInvalidEvictionPolicy = errors.New("invalid eviction policy")
+)
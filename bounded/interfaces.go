@@ -0,0 +1,14 @@
+package bounded
+
+import (
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+type (
+	// Bounded is the interface for size-capped cache implementations
+	Bounded interface {
+		gocache.Cache
+		Len() int
+		Cap() int
+	}
+)
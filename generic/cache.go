@@ -0,0 +1,161 @@
+package generic
+
+import (
+	"fmt"
+	"time"
+
+	gocache "github.com/ralvarezdev/go-cache"
+	"github.com/ralvarezdev/go-cache/timed"
+)
+
+type (
+	// KeyFunc converts a typed key into the string key used by the
+	// underlying timed.DefaultTimedCache
+	KeyFunc[K comparable] func(key K) string
+
+	// Cache is a type-safe wrapper around timed.DefaultTimedCache,
+	// eliminating the interface{} type assertions callers would otherwise
+	// need to do for every Set/Get
+	Cache[K comparable, V any] struct {
+		cache   *timed.DefaultTimedCache
+		keyFunc KeyFunc[K]
+	}
+)
+
+// NewCache creates a new Cache instance
+//
+// Parameters:
+//
+//   - keyFunc: Converts a K into the string key stored in the underlying
+//     cache; if nil, fmt.Sprint is used
+//
+// Returns:
+//
+//   - *Cache[K, V]: A pointer to the newly created cache instance
+func NewCache[K comparable, V any](keyFunc KeyFunc[K]) *Cache[K, V] {
+	if keyFunc == nil {
+		keyFunc = func(key K) string {
+			return fmt.Sprint(key)
+		}
+	}
+
+	return &Cache[K, V]{
+		cache:   timed.NewDefaultTimedCache(),
+		keyFunc: keyFunc,
+	}
+}
+
+// Set adds the value to the cache
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//   - ttl: How long the value should remain valid
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or the item has expired
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) error {
+	if c == nil {
+		return gocache.ErrNilCache
+	}
+	return c.cache.Set(
+		c.keyFunc(key),
+		timed.NewTimedItem(value, time.Now().Add(ttl)),
+	)
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in the cache and has not expired, false otherwise
+func (c *Cache[K, V]) Has(key K) bool {
+	if c == nil {
+		return false
+	}
+	return c.cache.Has(c.keyFunc(key))
+}
+
+// Get retrieves a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - V: The cached value, or the zero value of V if not found or expired
+//   - bool: True if the value was found and not expired, false otherwise
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	var zero V
+	if c == nil {
+		return zero, false
+	}
+
+	value, found := c.cache.Get(c.keyFunc(key))
+	if !found {
+		return zero, false
+	}
+
+	typed, ok := value.(V)
+	if !ok {
+		return zero, false
+	}
+	return typed, true
+}
+
+// Delete removes a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (c *Cache[K, V]) Delete(key K) {
+	if c == nil {
+		return
+	}
+	c.cache.Delete(c.keyFunc(key))
+}
+
+// GetOrSet returns the unexpired value stored under key, or computes it by
+// calling valueFn and stores the result, all under a single lock
+// acquisition on the underlying cache so concurrent misses do not
+// stampede the value's source
+//
+// Parameters:
+//
+//   - key: The key to retrieve or populate
+//   - valueFn: Computes the value, its ttl, and an error on a cache miss
+//
+// Returns:
+//
+//   - V: The existing or newly computed value
+//   - error: An error if the cache is nil or valueFn fails
+func (c *Cache[K, V]) GetOrSet(key K, valueFn func() (V, time.Duration, error)) (V, error) {
+	var zero V
+	if c == nil {
+		return zero, gocache.ErrNilCache
+	}
+
+	value, _, err := c.cache.GetOrSet(
+		c.keyFunc(key),
+		func() (interface{}, time.Duration, error) {
+			v, ttl, err := valueFn()
+			return v, ttl, err
+		},
+	)
+	if err != nil {
+		return zero, err
+	}
+
+	typed, ok := value.(V)
+	if !ok {
+		return zero, ErrValueTypeMismatch
+	}
+	return typed, nil
+}
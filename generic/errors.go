@@ -0,0 +1,9 @@
+package generic
+
+import (
+	"errors"
+)
+
+var (
+	ErrValueTypeMismatch = errors.New("cached value does not match the expected type")
+)
@@ -0,0 +1,261 @@
+package sharded
+
+import (
+	"hash/fnv"
+	"time"
+
+	"github.com/ralvarezdev/go-cache/timed"
+)
+
+const (
+	// DefaultShardCount is the number of shards used when NewShardedTimedCache
+	// is called without an explicit count
+	DefaultShardCount = 32
+)
+
+type (
+	// ShardedTimedCache is a timed.TimedCache implementation that spreads
+	// its entries across several independent timed.DefaultTimedCache
+	// shards, each guarded by its own mutex, to avoid a single global lock
+	// becoming a bottleneck under concurrent writes
+	ShardedTimedCache struct {
+		shards []*timed.DefaultTimedCache
+		mask   uint32
+	}
+)
+
+// NewShardedTimedCache creates a new ShardedTimedCache instance
+//
+// Parameters:
+//
+//   - numShards: The number of shards to create; rounded up to the next
+//     power of two, or DefaultShardCount if zero or negative
+//
+// Returns:
+//
+//   - *ShardedTimedCache: A pointer to the newly created cache instance
+func NewShardedTimedCache(numShards int) *ShardedTimedCache {
+	if numShards <= 0 {
+		numShards = DefaultShardCount
+	}
+	numShards = nextPowerOfTwo(numShards)
+
+	shards := make([]*timed.DefaultTimedCache, numShards)
+	for i := range shards {
+		shards[i] = timed.NewDefaultTimedCache()
+	}
+
+	return &ShardedTimedCache{
+		shards: shards,
+		mask:   uint32(numShards - 1),
+	}
+}
+
+// nextPowerOfTwo returns the smallest power of two greater than or equal to n
+//
+// Parameters:
+//
+//   - n: The lower bound
+//
+// Returns:
+//
+//   - int: The smallest power of two that is >= n
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// shardFor returns the shard responsible for key, selected via an fnv-1a
+// hash of the key masked to the shard count
+//
+// Parameters:
+//
+//   - key: The key to route to a shard
+//
+// Returns:
+//
+//   - *timed.DefaultTimedCache: The shard responsible for key
+func (s *ShardedTimedCache) shardFor(key string) *timed.DefaultTimedCache {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return s.shards[h.Sum32()&s.mask]
+}
+
+// Set adds the item to the cache
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The item to be cached
+//
+// Returns:
+//
+//   - error: An error if the item is nil or has expired
+func (s *ShardedTimedCache) Set(key string, value interface{}) error {
+	return s.shardFor(key).Set(key, value)
+}
+
+// UpdateValue updates the value of an item in the cache
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be set
+//
+// Returns:
+//
+//   - error: An error if the item is not found
+func (s *ShardedTimedCache) UpdateValue(key string, value interface{}) error {
+	return s.shardFor(key).UpdateValue(key, value)
+}
+
+// Has checks if the cache contains a key
+//
+// Parameters:
+//
+//   - key: The key to check in the cache
+//
+// Returns:
+//
+//   - bool: True if the key exists in the cache and has not expired, false otherwise
+func (s *ShardedTimedCache) Has(key string) bool {
+	return s.shardFor(key).Has(key)
+}
+
+// Get retrieves a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to retrieve from the cache
+//
+// Returns:
+//
+//   - interface{}: The cached value, or nil if not found or expired
+//   - bool: True if the value was found and not expired, false otherwise
+func (s *ShardedTimedCache) Get(key string) (interface{}, bool) {
+	return s.shardFor(key).Get(key)
+}
+
+// Delete removes a value from the cache
+//
+// Parameters:
+//
+//   - key: The key to remove from the cache
+func (s *ShardedTimedCache) Delete(key string) {
+	s.shardFor(key).Delete(key)
+}
+
+// GetExpirationTime retrieves the expiration time of a cached item
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//
+// Returns:
+//
+//   - time.Time: The expiration time of the cached item, or zero time if not found
+func (s *ShardedTimedCache) GetExpirationTime(key string) time.Time {
+	return s.shardFor(key).GetExpirationTime(key)
+}
+
+// UpdateExpirationTime updates the expiration time of a cached item
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - expiresAt: The new expiration time to be set
+//
+// Returns:
+//
+//   - error: An error if the item is not found
+func (s *ShardedTimedCache) UpdateExpirationTime(key string, expiresAt time.Time) error {
+	return s.shardFor(key).UpdateExpirationTime(key, expiresAt)
+}
+
+// Add stores the value under key only if the key does not already hold an
+// unexpired item
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//   - ttl: How long the value should remain valid
+//
+// Returns:
+//
+//   - error: An error if the key already holds an unexpired item
+func (s *ShardedTimedCache) Add(key string, value interface{}, ttl time.Duration) error {
+	return s.shardFor(key).Add(key, value, ttl)
+}
+
+// Replace stores the value under key only if the key already holds an
+// unexpired item
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be cached
+//   - ttl: How long the new value should remain valid
+//
+// Returns:
+//
+//   - error: An error if the key does not hold an unexpired item
+func (s *ShardedTimedCache) Replace(key string, value interface{}, ttl time.Duration) error {
+	return s.shardFor(key).Replace(key, value, ttl)
+}
+
+// GetOrSet returns the unexpired value stored under key, or computes it by
+// calling valueFn and stores the result, all under the responsible shard's
+// single lock acquisition
+//
+// Parameters:
+//
+//   - key: The key to retrieve or populate
+//   - valueFn: Computes the value, its ttl, and an error on a cache miss
+//
+// Returns:
+//
+//   - interface{}: The existing or newly computed value
+//   - bool: True if the value already existed in the cache, false if it was just computed
+//   - error: An error if valueFn fails
+func (s *ShardedTimedCache) GetOrSet(
+	key string,
+	valueFn func() (interface{}, time.Duration, error),
+) (interface{}, bool, error) {
+	return s.shardFor(key).GetOrSet(key, valueFn)
+}
+
+// Increment adds delta to the int64 value stored under key and returns the
+// result
+//
+// Parameters:
+//
+//   - key: The key associated with the counter
+//   - delta: The amount to add to the counter, which may be negative
+//
+// Returns:
+//
+//   - int64: The counter's value after applying delta
+//   - error: An error if the key is not found, has expired, or does not hold an int64
+func (s *ShardedTimedCache) Increment(key string, delta int64) (int64, error) {
+	return s.shardFor(key).Increment(key, delta)
+}
+
+// Decrement subtracts delta from the int64 value stored under key and
+// returns the result
+//
+// Parameters:
+//
+//   - key: The key associated with the counter
+//   - delta: The amount to subtract from the counter
+//
+// Returns:
+//
+//   - int64: The counter's value after subtracting delta
+//   - error: An error if the key is not found, has expired, or does not hold an int64
+func (s *ShardedTimedCache) Decrement(key string, delta int64) (int64, error) {
+	return s.shardFor(key).Decrement(key, delta)
+}
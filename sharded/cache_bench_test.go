@@ -0,0 +1,75 @@
+package sharded
+
+import (
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ralvarezdev/go-cache/timed"
+)
+
+// mixedWorkload exercises a cache with a mix of concurrent reads and writes
+// across a fixed set of keys, split across GOMAXPROCS goroutines
+func mixedWorkload(b *testing.B, set func(key string, value interface{}), get func(key string) (interface{}, bool)) {
+	const numKeys = 1024
+	keys := make([]string, numKeys)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+		set(keys[i], i)
+	}
+
+	b.ResetTimer()
+
+	goroutines := runtime.GOMAXPROCS(0)
+	perGoroutine := b.N / goroutines
+	if perGoroutine == 0 {
+		perGoroutine = 1
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				key := keys[(g+i)%numKeys]
+				if i%10 == 0 {
+					set(key, i)
+				} else {
+					get(key)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+// BenchmarkSingleMutexCache benchmarks mixed read/write throughput against a
+// plain timed.DefaultTimedCache, which serializes all access through one
+// sync.RWMutex
+func BenchmarkSingleMutexCache(b *testing.B) {
+	c := timed.NewDefaultTimedCache()
+	mixedWorkload(
+		b,
+		func(key string, value interface{}) {
+			_ = c.Set(key, timed.NewTimedItem(value, time.Now().Add(time.Hour)))
+		},
+		c.Get,
+	)
+}
+
+// BenchmarkShardedCache benchmarks mixed read/write throughput against a
+// ShardedTimedCache, which spreads keys across DefaultShardCount independent
+// shards to reduce lock contention
+func BenchmarkShardedCache(b *testing.B) {
+	c := NewShardedTimedCache(DefaultShardCount)
+	mixedWorkload(
+		b,
+		func(key string, value interface{}) {
+			_ = c.Set(key, timed.NewTimedItem(value, time.Now().Add(time.Hour)))
+		},
+		c.Get,
+	)
+}
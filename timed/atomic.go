@@ -0,0 +1,152 @@
+package timed
+
+import (
+	"time"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+// Add stores the value under key only if the key does not already hold an
+// unexpired item
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//   - ttl: How long the value should remain valid
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or the key already holds an unexpired item
+func (d *DefaultTimedCache) Add(key string, value interface{}, ttl time.Duration) error {
+	if d == nil {
+		return gocache.ErrNilCache
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if item, found := d.items[key]; found && !item.HasExpired() {
+		return ErrItemAlreadyExists
+	}
+
+	d.items[key] = NewTimedItem(value, time.Now().Add(ttl))
+	return nil
+}
+
+// Replace stores the value under key only if the key already holds an
+// unexpired item
+//
+// Parameters:
+//
+//   - key: The key associated with the cached value
+//   - value: The new value to be cached
+//   - ttl: How long the new value should remain valid
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or the key does not hold an unexpired item
+func (d *DefaultTimedCache) Replace(key string, value interface{}, ttl time.Duration) error {
+	if d == nil {
+		return gocache.ErrNilCache
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if item, found := d.items[key]; !found || item.HasExpired() {
+		return gocache.ErrItemNotFound
+	}
+
+	d.items[key] = NewTimedItem(value, time.Now().Add(ttl))
+	return nil
+}
+
+// GetOrSet returns the unexpired value stored under key, or computes it by
+// calling valueFn and stores the result, all under a single lock acquisition
+// so concurrent misses do not stampede the value's source
+//
+// Parameters:
+//
+//   - key: The key to retrieve or populate
+//   - valueFn: Computes the value, its ttl, and an error on a cache miss
+//
+// Returns:
+//
+//   - interface{}: The existing or newly computed value
+//   - bool: True if the value already existed in the cache, false if it was just computed
+//   - error: An error if the cache is nil or valueFn fails
+func (d *DefaultTimedCache) GetOrSet(
+	key string,
+	valueFn func() (interface{}, time.Duration, error),
+) (interface{}, bool, error) {
+	if d == nil {
+		return nil, false, gocache.ErrNilCache
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if item, found := d.items[key]; found && !item.HasExpired() {
+		return item.value, true, nil
+	}
+
+	value, ttl, err := valueFn()
+	if err != nil {
+		return nil, false, err
+	}
+
+	d.items[key] = NewTimedItem(value, time.Now().Add(ttl))
+	return value, false, nil
+}
+
+// Increment adds delta to the int64 value stored under key and returns the
+// result
+//
+// Parameters:
+//
+//   - key: The key associated with the counter
+//   - delta: The amount to add to the counter, which may be negative
+//
+// Returns:
+//
+//   - int64: The counter's value after applying delta
+//   - error: An error if the key is not found, has expired, or does not hold an int64
+func (d *DefaultTimedCache) Increment(key string, delta int64) (int64, error) {
+	if d == nil {
+		return 0, gocache.ErrNilCache
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	item, found := d.items[key]
+	if !found || item.HasExpired() {
+		return 0, gocache.ErrItemNotFound
+	}
+
+	value, ok := item.value.(int64)
+	if !ok {
+		return 0, ErrValueMustBeInt64
+	}
+
+	value += delta
+	item.value = value
+	return value, nil
+}
+
+// Decrement subtracts delta from the int64 value stored under key and
+// returns the result
+//
+// Parameters:
+//
+//   - key: The key associated with the counter
+//   - delta: The amount to subtract from the counter
+//
+// Returns:
+//
+//   - int64: The counter's value after subtracting delta
+//   - error: An error if the key is not found, has expired, or does not hold an int64
+func (d *DefaultTimedCache) Decrement(key string, delta int64) (int64, error) {
+	return d.Increment(key, -delta)
+}
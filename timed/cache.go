@@ -1,6 +1,7 @@
 package timed
 
 import (
+	"runtime"
 	"sync"
 	"time"
 
@@ -14,10 +15,30 @@ type (
 		value     interface{}
 	}
 
+	// timedCacheState holds the data a DefaultTimedCache operates on. It is
+	// kept separate from DefaultTimedCache itself so the janitor goroutine
+	// can reference it directly instead of the outer cache: if the
+	// goroutine held the outer *DefaultTimedCache, that reference would
+	// keep it permanently reachable and runtime.SetFinalizer would never
+	// fire, leaking the goroutine forever
+	timedCacheState struct {
+		items             map[string]*TimedItem
+		mutex             sync.RWMutex
+		onEvicted         func(key string, value interface{})
+		defaultExpiration time.Duration
+	}
+
 	// DefaultTimedCache represents an in-memory cache
 	DefaultTimedCache struct {
-		items map[string]*TimedItem
-		mutex sync.RWMutex
+		*timedCacheState
+		janitor *janitor
+	}
+
+	// janitor periodically removes expired items from a timedCacheState
+	janitor struct {
+		interval time.Duration
+		stop     chan struct{}
+		stopOnce sync.Once
 	}
 )
 
@@ -101,8 +122,166 @@ func (i *TimedItem) HasExpired() bool {
 // NewDefaultTimedCache creates a new DefaultTimedCache instance
 func NewDefaultTimedCache() *DefaultTimedCache {
 	return &DefaultTimedCache{
-		items: make(map[string]*TimedItem),
+		timedCacheState: &timedCacheState{
+			items: make(map[string]*TimedItem),
+		},
+	}
+}
+
+// NewDefaultTimedCacheWithJanitor creates a new DefaultTimedCache instance
+// with a background goroutine that periodically removes expired items,
+// instead of relying solely on lazy removal on Get
+//
+// Parameters:
+//
+//   - defaultExpiration: The TTL applied by SetDefault to items added without an explicit expiration
+//   - cleanupInterval: How often the janitor scans the cache for expired items
+//
+// Returns:
+//
+//   - *DefaultTimedCache: A pointer to the newly created cache instance
+func NewDefaultTimedCacheWithJanitor(
+	defaultExpiration, cleanupInterval time.Duration,
+) *DefaultTimedCache {
+	d := NewDefaultTimedCache()
+	d.defaultExpiration = defaultExpiration
+	runJanitor(d, cleanupInterval)
+	return d
+}
+
+// SetDefault adds the value to the cache using the cache's defaultExpiration
+// as its TTL, as configured via NewDefaultTimedCacheWithJanitor
+//
+// Parameters:
+//
+//   - key: The key to associate with the cached value
+//   - value: The value to be cached
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or the default expiration has already elapsed
+func (d *DefaultTimedCache) SetDefault(key string, value interface{}) error {
+	if d == nil {
+		return gocache.ErrNilCache
 	}
+	return d.Set(key, NewTimedItem(value, time.Now().Add(d.defaultExpiration)))
+}
+
+// OnEvicted registers a callback invoked with the key and value of every
+// item removed from the cache because it expired, whether it is reaped by
+// the janitor or lazily deleted by Get
+//
+// Parameters:
+//
+//   - fn: The callback to invoke on eviction
+func (d *DefaultTimedCache) OnEvicted(fn func(key string, value interface{})) {
+	if d == nil {
+		return
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.onEvicted = fn
+}
+
+// Stop halts the janitor goroutine, if one is running. It is safe to call
+// more than once, including concurrently with Close
+func (d *DefaultTimedCache) Stop() {
+	if d == nil || d.janitor == nil {
+		return
+	}
+	d.janitor.stopOnce.Do(
+		func() {
+			close(d.janitor.stop)
+		},
+	)
+}
+
+// Close is an alias for Stop, allowing DefaultTimedCache to be used with
+// patterns that expect an io.Closer-like method
+//
+// Returns:
+//
+//   - error: Always nil
+func (d *DefaultTimedCache) Close() error {
+	d.Stop()
+	return nil
+}
+
+// deleteExpired removes every expired item from the cache, firing
+// onEvicted for each one
+func (s *timedCacheState) deleteExpired() {
+	s.mutex.Lock()
+	var evicted map[string]interface{}
+	if s.onEvicted != nil {
+		evicted = make(map[string]interface{})
+	}
+
+	for key, item := range s.items {
+		if item.HasExpired() {
+			if evicted != nil {
+				evicted[key] = item.value
+			}
+			delete(s.items, key)
+		}
+	}
+	onEvicted := s.onEvicted
+	s.mutex.Unlock()
+
+	if onEvicted != nil {
+		for key, value := range evicted {
+			onEvicted(key, value)
+		}
+	}
+}
+
+// run starts the janitor loop, periodically deleting expired items from
+// the given cache state until Stop is called
+//
+// Parameters:
+//
+//   - state: The cache state to clean up
+func (j *janitor) run(state *timedCacheState) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			state.deleteExpired()
+		case <-j.stop:
+			return
+		}
+	}
+}
+
+// runJanitor attaches a janitor to the given cache and arranges for it to
+// stop leaking its goroutine via runtime.SetFinalizer when the cache is
+// garbage collected without an explicit Stop/Close call.
+//
+// The goroutine is started against d.timedCacheState rather than d itself,
+// so it never keeps the outer *DefaultTimedCache reachable; only that lets
+// the finalizer registered below ever fire for a dropped cache
+//
+// Parameters:
+//
+//   - d: The cache to attach the janitor to
+//   - interval: How often the janitor scans for expired items
+func runJanitor(d *DefaultTimedCache, interval time.Duration) {
+	j := &janitor{
+		interval: interval,
+		stop:     make(chan struct{}),
+	}
+	d.janitor = j
+
+	go j.run(d.timedCacheState)
+
+	runtime.SetFinalizer(
+		d, func(d *DefaultTimedCache) {
+			d.Stop()
+		},
+	)
 }
 
 // Set adds the item to the cache
@@ -242,21 +421,45 @@ func (d *DefaultTimedCache) Get(key string) (interface{}, bool) {
 
 	// Lock the cache
 	d.mutex.RLock()
-	defer d.mutex.RUnlock()
 
 	// Check if the item exists
 	item, found := d.items[key]
 	if !found {
+		d.mutex.RUnlock()
 		return nil, false
 	}
 
 	// Check if the item has expired, and remove it if it has
 	if item.HasExpired() {
+		d.mutex.RUnlock()
+
+		d.mutex.Lock()
+		// Re-check under the write lock: a concurrent Set or Delete may
+		// have replaced or removed this key between the RUnlock above and
+		// this Lock, and we must not evict whatever is there now
+		current, found := d.items[key]
+		if !found || current != item || !current.HasExpired() {
+			d.mutex.Unlock()
+			if found && current == item {
+				return current.value, true
+			}
+			return nil, false
+		}
+
+		value := item.value
 		delete(d.items, key)
+		onEvicted := d.onEvicted
+		d.mutex.Unlock()
+
+		if onEvicted != nil {
+			onEvicted(key, value)
+		}
 		return nil, false
 	}
 
-	return item.value, true
+	value := item.value
+	d.mutex.RUnlock()
+	return value, true
 }
 
 // Delete removes a value from the cache
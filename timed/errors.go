@@ -7,4 +7,6 @@ import (
 var (
 	ErrItemHasExpired        = errors.New("item has expired")
 	ErrValueMustBeATimedItem = errors.New("value must be a TimedItem")
+	ErrItemAlreadyExists     = errors.New("item already exists")
+	ErrValueMustBeInt64      = errors.New("value must be an int64")
 )
@@ -12,5 +12,10 @@ type (
 		gocache.Cache
 		GetExpirationTime(key string) time.Time
 		UpdateExpirationTime(key string, expirationTime time.Time) error
+		Add(key string, value interface{}, ttl time.Duration) error
+		Replace(key string, value interface{}, ttl time.Duration) error
+		GetOrSet(key string, valueFn func() (interface{}, time.Duration, error)) (interface{}, bool, error)
+		Increment(key string, delta int64) (int64, error)
+		Decrement(key string, delta int64) (int64, error)
 	}
 )
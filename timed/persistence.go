@@ -0,0 +1,136 @@
+package timed
+
+import (
+	"encoding/gob"
+	"io"
+	"os"
+	"time"
+
+	gocache "github.com/ralvarezdev/go-cache"
+)
+
+// snapshotItem is the gob-serializable representation of a TimedItem
+type snapshotItem struct {
+	Value     interface{}
+	ExpiresAt time.Time
+}
+
+// Register makes a concrete value type known to encoding/gob, so that
+// values stored behind the interface{} held by TimedItem can be
+// encoded and decoded by Save/Load and SaveFile/LoadFile
+//
+// Parameters:
+//
+//   - value: A zero value of the concrete type to register
+func Register(value interface{}) {
+	gob.Register(value)
+}
+
+// Save gob-encodes every unexpired item in the cache to w
+//
+// Parameters:
+//
+//   - w: The writer to encode the snapshot to
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or encoding fails
+func (d *DefaultTimedCache) Save(w io.Writer) error {
+	if d == nil {
+		return gocache.ErrNilCache
+	}
+
+	d.mutex.RLock()
+	snapshot := make(map[string]snapshotItem, len(d.items))
+	for key, item := range d.items {
+		if item.HasExpired() {
+			continue
+		}
+		snapshot[key] = snapshotItem{Value: item.value, ExpiresAt: item.expiresAt}
+	}
+	d.mutex.RUnlock()
+
+	return gob.NewEncoder(w).Encode(snapshot)
+}
+
+// SaveFile gob-encodes every unexpired item in the cache to the file at path,
+// creating it if necessary and truncating it if it already exists
+//
+// Parameters:
+//
+//   - path: The path of the file to write the snapshot to
+//
+// Returns:
+//
+//   - error: An error if the cache is nil, the file cannot be created, or encoding fails
+func (d *DefaultTimedCache) SaveFile(path string) error {
+	if d == nil {
+		return gocache.ErrNilCache
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return d.Save(file)
+}
+
+// Load decodes a snapshot produced by Save and merges it into the cache,
+// skipping any entry whose expiration time has already passed
+//
+// Parameters:
+//
+//   - r: The reader to decode the snapshot from
+//
+// Returns:
+//
+//   - error: An error if the cache is nil or decoding fails
+func (d *DefaultTimedCache) Load(r io.Reader) error {
+	if d == nil {
+		return gocache.ErrNilCache
+	}
+
+	var snapshot map[string]snapshotItem
+	if err := gob.NewDecoder(r).Decode(&snapshot); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for key, item := range snapshot {
+		if item.ExpiresAt.Before(now) {
+			continue
+		}
+		d.items[key] = NewTimedItem(item.Value, item.ExpiresAt)
+	}
+	return nil
+}
+
+// LoadFile decodes a snapshot produced by SaveFile at path and merges it
+// into the cache, skipping any entry whose expiration time has already passed
+//
+// Parameters:
+//
+//   - path: The path of the file to read the snapshot from
+//
+// Returns:
+//
+//   - error: An error if the cache is nil, the file cannot be opened, or decoding fails
+func (d *DefaultTimedCache) LoadFile(path string) error {
+	if d == nil {
+		return gocache.ErrNilCache
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return d.Load(file)
+}